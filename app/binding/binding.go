@@ -0,0 +1,91 @@
+// Package binding populates proto request messages from HTTP query
+// parameters and validates the result, so every REST handler parses
+// parameters the same way instead of each hand-rolling its own
+// r.URL.Query() lookups.
+package binding
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldError describes a validation failure for a single field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error aggregates the field errors from binding or validating a request,
+// rendered by the gateway as a structured 400 response.
+type Error struct {
+	Errors []FieldError `json:"errors"`
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return "binding error"
+	}
+
+	return fmt.Sprintf("%s: %s", e.Errors[0].Field, e.Errors[0].Message)
+}
+
+func (e *Error) add(field, message string) {
+	e.Errors = append(e.Errors, FieldError{Field: field, Message: message})
+}
+
+// Query populates msg's fields from r's query parameters. paramToField maps
+// a query parameter name (as it appears in the URL, e.g. "website_id") to
+// the proto field name it binds (e.g. "website_ids"), the same mapping a
+// proto RPC's `google.api.http` annotation would encode. Only string,
+// repeated string, and int32 fields are supported.
+func Query(r *http.Request, msg proto.Message, paramToField map[string]string) error {
+	values := r.URL.Query()
+	reflectMsg := msg.ProtoReflect()
+	fields := reflectMsg.Descriptor().Fields()
+	bindErr := &Error{}
+
+	for param, fieldName := range paramToField {
+		raw, ok := values[param]
+		if !ok {
+			continue
+		}
+
+		field := fields.ByName(protoreflect.Name(fieldName))
+		if field == nil {
+			bindErr.add(param, fmt.Sprintf("no such field %q", fieldName))
+			continue
+		}
+
+		switch {
+		case field.IsList() && field.Kind() == protoreflect.StringKind:
+			list := reflectMsg.Mutable(field).List()
+			for _, v := range raw {
+				if v == "" {
+					continue
+				}
+				list.Append(protoreflect.ValueOfString(v))
+			}
+		case field.Kind() == protoreflect.StringKind:
+			reflectMsg.Set(field, protoreflect.ValueOfString(raw[0]))
+		case field.Kind() == protoreflect.Int32Kind:
+			n, err := strconv.ParseInt(raw[0], 10, 32)
+			if err != nil {
+				bindErr.add(param, "must be an integer")
+				continue
+			}
+			reflectMsg.Set(field, protoreflect.ValueOfInt32(int32(n)))
+		default:
+			bindErr.add(param, "unsupported field type for query binding")
+		}
+	}
+
+	if len(bindErr.Errors) > 0 {
+		return bindErr
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package binding_test
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/lassenordahl/mall/app/binding"
+	pb "github.com/lassenordahl/mall/proto"
+)
+
+var websiteIDsQueryParams = map[string]string{
+	"website_id": "website_ids",
+	"top_k":      "top_k",
+}
+
+func newRequest(rawQuery string) *http.Request {
+	return &http.Request{URL: &url.URL{RawQuery: rawQuery}}
+}
+
+func TestQueryBindsRepeatedAndScalarFields(t *testing.T) {
+	req := &pb.GetRelatedEmbeddingsRequest{}
+	if err := binding.Query(newRequest("website_id=1&website_id=2&top_k=5"), req, websiteIDsQueryParams); err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+
+	if got, want := req.WebsiteIds, []string{"1", "2"}; !equalStrings(got, want) {
+		t.Errorf("WebsiteIds = %v, want %v", got, want)
+	}
+	if req.TopK != 5 {
+		t.Errorf("TopK = %d, want 5", req.TopK)
+	}
+}
+
+func TestQuerySkipsBlankRepeatedValues(t *testing.T) {
+	req := &pb.GetRelatedEmbeddingsRequest{}
+	if err := binding.Query(newRequest("website_id=&website_id=2"), req, websiteIDsQueryParams); err != nil {
+		t.Fatalf("Query returned error: %v", err)
+	}
+
+	if got, want := req.WebsiteIds, []string{"2"}; !equalStrings(got, want) {
+		t.Errorf("WebsiteIds = %v, want %v (blank values should be dropped)", got, want)
+	}
+}
+
+func TestQueryRejectsNonIntegerScalar(t *testing.T) {
+	req := &pb.GetRelatedEmbeddingsRequest{}
+	err := binding.Query(newRequest("top_k=notanumber"), req, websiteIDsQueryParams)
+	if err == nil {
+		t.Fatal("expected an error for a non-integer top_k")
+	}
+
+	var bindErr *binding.Error
+	if !errors.As(err, &bindErr) {
+		t.Fatalf("expected a *binding.Error, got %T: %v", err, err)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package binding
+
+import "fmt"
+
+// NonEmpty returns a FieldError if values is empty.
+func NonEmpty(field string, values []string) *FieldError {
+	if len(values) == 0 {
+		return &FieldError{Field: field, Message: "must not be empty"}
+	}
+
+	return nil
+}
+
+// MaxLen returns a FieldError if values has more than max elements.
+func MaxLen(field string, values []string, max int) *FieldError {
+	if len(values) > max {
+		return &FieldError{Field: field, Message: fmt.Sprintf("must have at most %d values", max)}
+	}
+
+	return nil
+}
+
+// Positive returns a FieldError if value is not greater than zero.
+func Positive(field string, value int32) *FieldError {
+	if value <= 0 {
+		return &FieldError{Field: field, Message: "must be greater than zero"}
+	}
+
+	return nil
+}
+
+// Dedupe returns values with duplicates removed, preserving the first
+// occurrence's order.
+func Dedupe(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+
+	return out
+}
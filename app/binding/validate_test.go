@@ -0,0 +1,45 @@
+package binding_test
+
+import (
+	"testing"
+
+	"github.com/lassenordahl/mall/app/binding"
+)
+
+func TestNonEmpty(t *testing.T) {
+	if fe := binding.NonEmpty("website_id", nil); fe == nil {
+		t.Error("NonEmpty(nil) = nil, want a FieldError")
+	}
+	if fe := binding.NonEmpty("website_id", []string{"1"}); fe != nil {
+		t.Errorf("NonEmpty([1]) = %v, want nil", fe)
+	}
+}
+
+func TestMaxLen(t *testing.T) {
+	if fe := binding.MaxLen("website_id", []string{"1", "2", "3"}, 2); fe == nil {
+		t.Error("MaxLen with 3 values and max 2 = nil, want a FieldError")
+	}
+	if fe := binding.MaxLen("website_id", []string{"1", "2"}, 2); fe != nil {
+		t.Errorf("MaxLen with 2 values and max 2 = %v, want nil", fe)
+	}
+}
+
+func TestPositive(t *testing.T) {
+	if fe := binding.Positive("top_k", 0); fe == nil {
+		t.Error("Positive(0) = nil, want a FieldError")
+	}
+	if fe := binding.Positive("top_k", -1); fe == nil {
+		t.Error("Positive(-1) = nil, want a FieldError")
+	}
+	if fe := binding.Positive("top_k", 1); fe != nil {
+		t.Errorf("Positive(1) = %v, want nil", fe)
+	}
+}
+
+func TestDedupe(t *testing.T) {
+	got := binding.Dedupe([]string{"1", "2", "1", "3", "2"})
+	want := []string{"1", "2", "3"}
+	if !equalStrings(got, want) {
+		t.Errorf("Dedupe = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,15 @@
+// Package codec lets the REST gateway negotiate a wire format with the
+// client instead of always responding with protojson, mirroring the
+// wrapCodec pattern gRPC codec plugins use to swap marshaling strategies
+// without touching call sites.
+package codec
+
+import "google.golang.org/protobuf/proto"
+
+// Codec marshals and unmarshals proto messages for a single wire content
+// type.
+type Codec interface {
+	Marshal(msg proto.Message) ([]byte, error)
+	Unmarshal(data []byte, msg proto.Message) error
+	ContentType() string
+}
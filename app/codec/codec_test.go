@@ -0,0 +1,48 @@
+package codec_test
+
+import (
+	"testing"
+
+	"github.com/lassenordahl/mall/app/codec"
+	pb "github.com/lassenordahl/mall/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCodecsRoundTrip(t *testing.T) {
+	registry := codec.NewRegistry()
+
+	for _, contentType := range []string{"application/json", "application/x-protobuf", "application/msgpack"} {
+		t.Run(contentType, func(t *testing.T) {
+			c := registry.Lookup(contentType)
+			if c.ContentType() != contentType {
+				t.Fatalf("Lookup(%q).ContentType() = %q", contentType, c.ContentType())
+			}
+
+			want := &pb.Embedding{WebsiteId: "1", Vector: []float32{0.5, 1.5}}
+
+			body, err := c.Marshal(want)
+			if err != nil {
+				t.Fatalf("Marshal: %v", err)
+			}
+
+			got := &pb.Embedding{}
+			if err := c.Unmarshal(body, got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			if !proto.Equal(want, got) {
+				t.Errorf("round trip = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestRegistryLookupFallsBackToJSON(t *testing.T) {
+	registry := codec.NewRegistry()
+
+	for _, contentType := range []string{"", "*/*", "application/unknown"} {
+		if got := registry.Lookup(contentType); got.ContentType() != "application/json" {
+			t.Errorf("Lookup(%q).ContentType() = %q, want application/json", contentType, got.ContentType())
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package codec
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// jsonCodec is the default codec, used whenever a client doesn't ask for
+// anything more specific.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(msg proto.Message) ([]byte, error) {
+	return protojson.Marshal(msg)
+}
+
+func (jsonCodec) Unmarshal(data []byte, msg proto.Message) error {
+	return protojson.Unmarshal(data, msg)
+}
+
+func (jsonCodec) ContentType() string {
+	return "application/json"
+}
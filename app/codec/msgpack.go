@@ -0,0 +1,46 @@
+package codec
+
+import (
+	"encoding/json"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// msgpackCodec round-trips proto messages through their protojson form
+// rather than duplicating proto's field naming and enum/any handling via
+// reflection.
+type msgpackCodec struct{}
+
+func (msgpackCodec) Marshal(msg proto.Message) ([]byte, error) {
+	jsonBody, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(jsonBody, &v); err != nil {
+		return nil, err
+	}
+
+	return msgpack.Marshal(v)
+}
+
+func (msgpackCodec) Unmarshal(data []byte, msg proto.Message) error {
+	var v interface{}
+	if err := msgpack.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	jsonBody, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return protojson.Unmarshal(jsonBody, msg)
+}
+
+func (msgpackCodec) ContentType() string {
+	return "application/msgpack"
+}
@@ -0,0 +1,19 @@
+package codec
+
+import "google.golang.org/protobuf/proto"
+
+// protobufCodec returns the raw serialized proto bytes, skipping JSON
+// entirely for clients that can decode the wire format directly.
+type protobufCodec struct{}
+
+func (protobufCodec) Marshal(msg proto.Message) ([]byte, error) {
+	return proto.Marshal(msg)
+}
+
+func (protobufCodec) Unmarshal(data []byte, msg proto.Message) error {
+	return proto.Unmarshal(data, msg)
+}
+
+func (protobufCodec) ContentType() string {
+	return "application/x-protobuf"
+}
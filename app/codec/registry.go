@@ -0,0 +1,32 @@
+package codec
+
+// Registry resolves a Codec by content type.
+type Registry struct {
+	codecs map[string]Codec
+}
+
+// NewRegistry returns a Registry pre-populated with the JSON, protobuf, and
+// msgpack codecs.
+func NewRegistry() *Registry {
+	r := &Registry{codecs: map[string]Codec{}}
+	r.Register(jsonCodec{})
+	r.Register(protobufCodec{})
+	r.Register(msgpackCodec{})
+
+	return r
+}
+
+// Register adds or replaces the codec used for its ContentType.
+func (r *Registry) Register(c Codec) {
+	r.codecs[c.ContentType()] = c
+}
+
+// Lookup returns the codec registered for contentType, falling back to JSON
+// when contentType is empty, "*/*", or unrecognized.
+func (r *Registry) Lookup(contentType string) Codec {
+	if c, ok := r.codecs[contentType]; ok {
+		return c
+	}
+
+	return r.codecs[(jsonCodec{}).ContentType()]
+}
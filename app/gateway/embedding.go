@@ -0,0 +1,114 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/lassenordahl/mall/app/binding"
+	pb "github.com/lassenordahl/mall/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxWebsiteIDs bounds how many website_id values a single request can ask
+// for.
+const maxWebsiteIDs = 50
+
+// websiteIDsQueryParams maps the /embeddings query parameters to the proto
+// fields they bind.
+var websiteIDsQueryParams = map[string]string{
+	"website_id": "website_ids",
+	"top_k":      "top_k",
+}
+
+// Dialer returns a client for EmbeddingService along with a cleanup func to
+// release any resources (e.g. a gRPC connection) once the request completes.
+type Dialer func(ctx context.Context) (pb.EmbeddingServiceClient, func(), error)
+
+// RegisterEmbeddingService hand-registers a REST route for every RPC on
+// EmbeddingService. Each new RPC needs its own call here and its own Bind
+// function below, since this repo doesn't vendor google/api/annotations.proto
+// and so has no annotation-driven codegen to derive routes from (see
+// proto/embedding.proto and the gateway package doc comment).
+func RegisterEmbeddingService(mux *Mux, dial Dialer) {
+	mux.Handle(Route{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings",
+		Bind:    bindGetRelatedEmbeddingsRequest,
+		Invoke: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			client, release, err := dial(ctx)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+
+			return client.GetRelatedEmbeddings(ctx, req.(*pb.GetRelatedEmbeddingsRequest))
+		},
+	})
+
+	mux.HandleStream(StreamRoute{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings/stream",
+		Bind:    bindGetRelatedEmbeddingsRequest,
+		Invoke: func(ctx context.Context, req proto.Message) (StreamReceiver, error) {
+			client, release, err := dial(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			stream, err := client.StreamRelatedEmbeddings(ctx, req.(*pb.GetRelatedEmbeddingsRequest))
+			if err != nil {
+				release()
+				return nil, err
+			}
+
+			return &embeddingStreamReceiver{stream: stream, release: release}, nil
+		},
+	})
+}
+
+// embeddingStreamReceiver adapts the generated
+// EmbeddingService_StreamRelatedEmbeddingsClient to the gateway's
+// StreamReceiver interface, releasing the underlying connection once Recv
+// returns an error (including io.EOF). Release does not run if the caller
+// stops calling Recv before that.
+type embeddingStreamReceiver struct {
+	stream  pb.EmbeddingService_StreamRelatedEmbeddingsClient
+	release func()
+}
+
+func (r *embeddingStreamReceiver) Recv() (proto.Message, error) {
+	msg, err := r.stream.Recv()
+	if err != nil {
+		r.release()
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func bindGetRelatedEmbeddingsRequest(r *http.Request) (proto.Message, error) {
+	req := &pb.GetRelatedEmbeddingsRequest{}
+	if err := binding.Query(r, req, websiteIDsQueryParams); err != nil {
+		return nil, err
+	}
+
+	req.WebsiteIds = binding.Dedupe(req.WebsiteIds)
+
+	bindErr := &binding.Error{}
+	if fe := binding.NonEmpty("website_id", req.WebsiteIds); fe != nil {
+		bindErr.Errors = append(bindErr.Errors, *fe)
+	}
+	if fe := binding.MaxLen("website_id", req.WebsiteIds, maxWebsiteIDs); fe != nil {
+		bindErr.Errors = append(bindErr.Errors, *fe)
+	}
+	if r.URL.Query().Get("top_k") != "" {
+		if fe := binding.Positive("top_k", req.TopK); fe != nil {
+			bindErr.Errors = append(bindErr.Errors, *fe)
+		}
+	}
+	if len(bindErr.Errors) > 0 {
+		return nil, bindErr
+	}
+
+	return req, nil
+}
@@ -0,0 +1,293 @@
+// Package gateway is a small grpc-gateway-style reverse proxy: each service
+// (see embedding.go) registers a hand-written Route/StreamRoute per RPC that
+// binds an incoming HTTP request onto the corresponding proto request
+// message, invokes the gRPC method, and marshals the response back. Routes
+// aren't generated from proto annotations — this repo doesn't vendor
+// google/api/annotations.proto (see proto/embedding.proto) — so a new RPC
+// still needs its own Bind function and RegisterEmbeddingService call, but
+// every route shares this package's dispatch, codec negotiation, and error
+// handling instead of duplicating its own dial-and-encode handler.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/lassenordahl/mall/app/binding"
+	"github.com/lassenordahl/mall/app/codec"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Binder extracts a proto request message from an incoming HTTP request,
+// binding its query parameters onto the proto fields the handler names.
+type Binder func(r *http.Request) (proto.Message, error)
+
+// Invoker calls the gRPC method backing a route and returns its response
+// message.
+type Invoker func(ctx context.Context, req proto.Message) (proto.Message, error)
+
+// Route describes a single REST endpoint, hand-registered via Mux.Handle by
+// the service it belongs to (see RegisterEmbeddingService in embedding.go).
+type Route struct {
+	Method  string
+	Pattern string
+	Bind    Binder
+	Invoke  Invoker
+}
+
+// StreamReceiver yields one proto message at a time, returning io.EOF once
+// the stream is exhausted.
+type StreamReceiver interface {
+	Recv() (proto.Message, error)
+}
+
+// StreamInvoker calls a server-streaming gRPC method backing a route and
+// returns a receiver for its responses.
+type StreamInvoker func(ctx context.Context, req proto.Message) (StreamReceiver, error)
+
+// StreamRoute describes a REST endpoint for a server-streaming RPC,
+// hand-registered via Mux.HandleStream. Responses are written one frame per
+// message (see negotiateStreamFraming) so callers can consume results as
+// they arrive instead of waiting for the full result set to buffer.
+type StreamRoute struct {
+	Method  string
+	Pattern string
+	Bind    Binder
+	Invoke  StreamInvoker
+}
+
+// Mux dispatches REST requests to their bound gRPC method.
+type Mux struct {
+	routes       []Route
+	streamRoutes []StreamRoute
+	codecs       *codec.Registry
+}
+
+// NewMux returns an empty Mux ready to have routes registered on it.
+func NewMux() *Mux {
+	return &Mux{codecs: codec.NewRegistry()}
+}
+
+// Handle registers a REST route for a unary RPC.
+func (m *Mux) Handle(route Route) {
+	m.routes = append(m.routes, route)
+}
+
+// HandleStream registers a REST route for a server-streaming RPC.
+func (m *Mux) HandleStream(route StreamRoute) {
+	m.streamRoutes = append(m.streamRoutes, route)
+}
+
+func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for _, route := range m.streamRoutes {
+		if route.Method != r.Method || !pathMatches(route.Pattern, r.URL.Path) {
+			continue
+		}
+
+		m.serveStream(w, r, route)
+		return
+	}
+
+	for _, route := range m.routes {
+		if route.Method != r.Method || !pathMatches(route.Pattern, r.URL.Path) {
+			continue
+		}
+
+		req, err := route.Bind(r)
+		if err != nil {
+			writeBindError(w, err)
+			return
+		}
+
+		resp, err := route.Invoke(r.Context(), req)
+		if err != nil {
+			writeInvokeError(w, err)
+			return
+		}
+
+		c := m.codecs.Lookup(preferredContentType(r))
+
+		body, err := c.Marshal(resp)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", c.ContentType())
+		w.Write(body)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// preferredContentType returns the first content type listed in the
+// request's Accept header (ignoring any "q" weighting), or "" if none was
+// sent.
+func preferredContentType(r *http.Request) string {
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return ""
+	}
+
+	first := strings.TrimSpace(strings.Split(accept, ",")[0])
+	return strings.TrimSpace(strings.Split(first, ";")[0])
+}
+
+// writeBindError renders a binding.Error as a structured 400 response with
+// field-level detail, falling back to a plain-text 400 for any other
+// binding failure.
+func writeBindError(w http.ResponseWriter, err error) {
+	var bindErr *binding.Error
+	if errors.As(err, &bindErr) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(bindErr)
+		return
+	}
+
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// writeInvokeError maps err's gRPC status code to the equivalent HTTP
+// status, the way grpc-gateway's default error mapping does, so REST
+// clients get more than a flat 500 for every backend failure.
+func writeInvokeError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), httpStatusFromError(err))
+}
+
+func httpStatusFromError(err error) int {
+	st, ok := status.FromError(err)
+	if !ok {
+		return http.StatusInternalServerError
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// streamFraming writes one message body as a single frame of a streaming
+// response, in either NDJSON or Server-Sent Events form.
+type streamFraming struct {
+	contentType string
+	writeFrame  func(w io.Writer, body []byte)
+}
+
+var ndjsonFraming = streamFraming{
+	contentType: "application/x-ndjson",
+	writeFrame: func(w io.Writer, body []byte) {
+		w.Write(body)
+		w.Write([]byte("\n"))
+	},
+}
+
+var sseFraming = streamFraming{
+	contentType: "text/event-stream",
+	writeFrame: func(w io.Writer, body []byte) {
+		w.Write([]byte("data: "))
+		w.Write(body)
+		w.Write([]byte("\n\n"))
+	},
+}
+
+// negotiateStreamFraming picks SSE framing when the client's Accept header
+// asks for "text/event-stream", falling back to NDJSON otherwise.
+func negotiateStreamFraming(r *http.Request) streamFraming {
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		return sseFraming
+	}
+
+	return ndjsonFraming
+}
+
+// serveStream binds and invokes a streaming route, then relays each message
+// as one frame of the negotiated format, flushing after every write so the
+// client sees results incrementally rather than after the whole stream
+// completes.
+func (m *Mux) serveStream(w http.ResponseWriter, r *http.Request, route StreamRoute) {
+	req, err := route.Bind(r)
+	if err != nil {
+		writeBindError(w, err)
+		return
+	}
+
+	recv, err := route.Invoke(r.Context(), req)
+	if err != nil {
+		writeInvokeError(w, err)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	framing := negotiateStreamFraming(r)
+	w.Header().Set("Content-Type", framing.contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		default:
+		}
+
+		msg, err := recv.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			log.Printf("gateway: stream error: %v", err)
+			return
+		}
+
+		body, err := protojson.Marshal(msg)
+		if err != nil {
+			log.Printf("gateway: marshal error: %v", err)
+			return
+		}
+
+		framing.writeFrame(w, body)
+		flusher.Flush()
+	}
+}
+
+// pathMatches reports whether path is an exact match for pattern. Routes
+// only need literal paths today ("/embeddings", "/embeddings/stream");
+// there's no path-parameter placeholder support, since nothing would bind
+// an extracted segment onto a proto field (query parameters are bound via
+// binding.Query instead — see bindGetRelatedEmbeddingsRequest).
+func pathMatches(pattern, path string) bool {
+	return strings.Trim(pattern, "/") == strings.Trim(path, "/")
+}
@@ -0,0 +1,241 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/lassenordahl/mall/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestPathMatches(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/embeddings", "/embeddings", true},
+		{"/embeddings", "embeddings", true},
+		{"/embeddings", "/embeddings/", true},
+		{"/embeddings", "/embeddings/stream", false},
+		{"/embeddings/stream", "/embeddings", false},
+		{"/embeddings/{website_id}", "/embeddings/1", false},
+	}
+
+	for _, tt := range tests {
+		if got := pathMatches(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("pathMatches(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestMuxServeHTTPDispatchesToMatchingRoute(t *testing.T) {
+	mux := NewMux()
+	mux.Handle(Route{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings",
+		Bind: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsRequest{}, nil
+		},
+		Invoke: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsResponse{
+				Embeddings: []*pb.Embedding{{WebsiteId: "1"}},
+			}, nil
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embeddings", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d; body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+}
+
+func TestMuxServeHTTPNotFoundForUnregisteredPath(t *testing.T) {
+	mux := NewMux()
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/nope", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestMuxServeHTTPWritesInvokeErrorWithMappedStatus(t *testing.T) {
+	mux := NewMux()
+	mux.Handle(Route{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings",
+		Bind: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsRequest{}, nil
+		},
+		Invoke: func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return nil, status.New(codes.NotFound, "no such website").Err()
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embeddings", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHTTPStatusFromError(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unimplemented, http.StatusNotImplemented},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.Internal, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		err := status.New(tt.code, "boom").Err()
+		if got := httpStatusFromError(err); got != tt.want {
+			t.Errorf("httpStatusFromError(%v) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+
+	if got := httpStatusFromError(io.EOF); got != http.StatusInternalServerError {
+		t.Errorf("httpStatusFromError(non-status error) = %d, want %d", got, http.StatusInternalServerError)
+	}
+}
+
+func TestNegotiateStreamFraming(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   streamFraming
+	}{
+		{"", ndjsonFraming},
+		{"application/json", ndjsonFraming},
+		{"text/event-stream", sseFraming},
+		{"text/html, text/event-stream;q=0.9", sseFraming},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/embeddings/stream", nil)
+		if tt.accept != "" {
+			r.Header.Set("Accept", tt.accept)
+		}
+
+		got := negotiateStreamFraming(r)
+		if got.contentType != tt.want.contentType {
+			t.Errorf("negotiateStreamFraming(Accept=%q).contentType = %q, want %q", tt.accept, got.contentType, tt.want.contentType)
+		}
+	}
+}
+
+// fakeStreamReceiver replays a fixed slice of proto messages, then io.EOF.
+type fakeStreamReceiver struct {
+	msgs []proto.Message
+	i    int
+}
+
+func (f *fakeStreamReceiver) Recv() (proto.Message, error) {
+	if f.i >= len(f.msgs) {
+		return nil, io.EOF
+	}
+
+	msg := f.msgs[f.i]
+	f.i++
+	return msg, nil
+}
+
+func TestMuxServeHTTPStreamsNDJSONFramesByDefault(t *testing.T) {
+	mux := NewMux()
+	mux.HandleStream(StreamRoute{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings/stream",
+		Bind: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsRequest{}, nil
+		},
+		Invoke: func(ctx context.Context, req proto.Message) (StreamReceiver, error) {
+			return &fakeStreamReceiver{msgs: []proto.Message{
+				&pb.Embedding{WebsiteId: "1"},
+				&pb.Embedding{WebsiteId: "2"},
+			}}, nil
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embeddings/stream", nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("Content-Type = %q, want application/x-ndjson", ct)
+	}
+
+	lines := strings.Split(strings.TrimRight(rec.Body.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d frames, want 2: %q", len(lines), rec.Body.String())
+	}
+	if !strings.Contains(lines[0], `"1"`) || !strings.Contains(lines[1], `"2"`) {
+		t.Errorf("frames = %v, want websiteIds 1 and 2", lines)
+	}
+}
+
+func TestMuxServeHTTPStreamsSSEWhenRequested(t *testing.T) {
+	mux := NewMux()
+	mux.HandleStream(StreamRoute{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings/stream",
+		Bind: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsRequest{}, nil
+		},
+		Invoke: func(ctx context.Context, req proto.Message) (StreamReceiver, error) {
+			return &fakeStreamReceiver{msgs: []proto.Message{&pb.Embedding{WebsiteId: "1"}}}, nil
+		},
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/embeddings/stream", nil)
+	r.Header.Set("Accept", "text/event-stream")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, r)
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", ct)
+	}
+	if body := rec.Body.String(); !strings.HasPrefix(body, "data: ") || !strings.HasSuffix(body, "\n\n") {
+		t.Errorf("body = %q, want an SSE-framed event", body)
+	}
+}
+
+func TestMuxServeHTTPStreamWritesInvokeErrorWithMappedStatus(t *testing.T) {
+	mux := NewMux()
+	mux.HandleStream(StreamRoute{
+		Method:  http.MethodGet,
+		Pattern: "/embeddings/stream",
+		Bind: func(r *http.Request) (proto.Message, error) {
+			return &pb.GetRelatedEmbeddingsRequest{}, nil
+		},
+		Invoke: func(ctx context.Context, req proto.Message) (StreamReceiver, error) {
+			return nil, status.New(codes.NotFound, "no such website").Err()
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/embeddings/stream", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	pb "github.com/lassenordahl/mall/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufconnBufSize = 1 << 20
+
+// retryServiceConfig enables gRPC's built-in retry policy for transient
+// failures on the pooled connection, since the pool no longer redials per
+// request to paper over them.
+const retryServiceConfig = `{
+	"methodConfig": [{
+		"name": [{"service": "mall.EmbeddingService"}],
+		"retryPolicy": {
+			"maxAttempts": 3,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2.0,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
+// ClientPool hands out a long-lived EmbeddingServiceClient instead of
+// dialing a new connection per request.
+type ClientPool struct {
+	client pb.EmbeddingServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewInProcessClientPool dials grpcServer over an in-memory bufconn
+// listener, since the REST gateway and the gRPC service are colocated in
+// this process and don't need to loop back over the network.
+func NewInProcessClientPool(ctx context.Context, grpcServer *grpc.Server) (*ClientPool, error) {
+	listener := bufconn.Listen(bufconnBufSize)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil {
+			log.Printf("embedding service bufconn listener stopped: %v", err)
+		}
+	}()
+
+	conn, err := grpc.DialContext(ctx, "bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                10 * time.Second,
+			Timeout:             5 * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultServiceConfig(retryServiceConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientPool{client: pb.NewEmbeddingServiceClient(conn), conn: conn}, nil
+}
+
+// Get returns the pooled client. Callers should propagate the incoming
+// request's context so deadlines and cancellation flow through to the RPC
+// instead of using context.Background().
+func (p *ClientPool) Get(ctx context.Context) (pb.EmbeddingServiceClient, func(), error) {
+	return p.client, func() {}, nil
+}
+
+// Close releases the pooled connection.
+func (p *ClientPool) Close() error {
+	return p.conn.Close()
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/lassenordahl/mall/proto"
+	"google.golang.org/grpc"
+)
+
+func TestInProcessClientPoolRoundTripsThroughBufconn(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	pb.RegisterEmbeddingServiceServer(grpcServer, newEmbeddingServiceServer())
+	defer grpcServer.Stop()
+
+	pool, err := NewInProcessClientPool(context.Background(), grpcServer)
+	if err != nil {
+		t.Fatalf("NewInProcessClientPool: %v", err)
+	}
+	defer pool.Close()
+
+	client, release, err := pool.Get(context.Background())
+	if err != nil {
+		t.Fatalf("pool.Get: %v", err)
+	}
+	defer release()
+
+	resp, err := client.GetRelatedEmbeddings(context.Background(), &pb.GetRelatedEmbeddingsRequest{
+		WebsiteIds: []string{"a", "b"},
+	})
+	if err != nil {
+		t.Fatalf("GetRelatedEmbeddings: %v", err)
+	}
+
+	if len(resp.Embeddings) != 2 || resp.Embeddings[0].WebsiteId != "a" || resp.Embeddings[1].WebsiteId != "b" {
+		t.Errorf("Embeddings = %v, want embeddings for a and b", resp.Embeddings)
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/lassenordahl/mall/proto"
+)
+
+// embeddingServiceServer is the in-process EmbeddingService implementation
+// that the REST gateway now talks to directly instead of dialing out to a
+// separately hosted gRPC server.
+type embeddingServiceServer struct {
+	pb.UnimplementedEmbeddingServiceServer
+}
+
+func newEmbeddingServiceServer() *embeddingServiceServer {
+	return &embeddingServiceServer{}
+}
+
+func (s *embeddingServiceServer) GetRelatedEmbeddings(ctx context.Context, req *pb.GetRelatedEmbeddingsRequest) (*pb.GetRelatedEmbeddingsResponse, error) {
+	resp := &pb.GetRelatedEmbeddingsResponse{}
+	for _, websiteID := range req.GetWebsiteIds() {
+		resp.Embeddings = append(resp.Embeddings, &pb.Embedding{WebsiteId: websiteID})
+	}
+
+	return resp, nil
+}
+
+// StreamRelatedEmbeddings sends one Embedding per website_id as it becomes
+// available instead of buffering the full result set like
+// GetRelatedEmbeddings does.
+func (s *embeddingServiceServer) StreamRelatedEmbeddings(req *pb.GetRelatedEmbeddingsRequest, stream pb.EmbeddingService_StreamRelatedEmbeddingsServer) error {
+	for _, websiteID := range req.GetWebsiteIds() {
+		if err := stream.Send(&pb.Embedding{WebsiteId: websiteID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
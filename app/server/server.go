@@ -2,45 +2,75 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"flag"
 	"log"
 	"net/http"
+	"strings"
 
+	"github.com/lassenordahl/mall/app/gateway"
 	pb "github.com/lassenordahl/mall/proto"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 )
 
-type server struct{}
+// addr is the single listener both the gRPC server and the REST gateway are
+// served on.
+const addr = ":8080"
 
-func (s *server) getEmbeddings(w http.ResponseWriter, r *http.Request) {
-  conn, err := grpc.Dial("localhost:50051", grpc.WithInsecure())
+// grpcOrREST multiplexes a single listener between grpcHandler and restMux by
+// inspecting the request the way h2c-aware reverse proxies do: HTTP/2
+// requests carrying a "application/grpc" content type go to grpcHandler,
+// everything else falls through to the REST gateway. grpcHandler is typed as
+// http.Handler (rather than *grpc.Server, which satisfies it) so tests can
+// stand in a fake without spinning up a real gRPC server.
+func grpcOrREST(grpcHandler, restMux http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
+			grpcHandler.ServeHTTP(w, r)
+			return
+		}
+
+		restMux.ServeHTTP(w, r)
+	})
+}
+
+func main() {
+	certFile := flag.String("tls-cert", "", "path to a TLS certificate; when set (with -tls-key) the combined listener serves HTTP/2 over TLS instead of cleartext h2c")
+	keyFile := flag.String("tls-key", "", "path to the private key for -tls-cert")
+	flag.Parse()
+
+	grpcServer := grpc.NewServer()
+	pb.RegisterEmbeddingServiceServer(grpcServer, newEmbeddingServiceServer())
+
+	pool, err := NewInProcessClientPool(context.Background(), grpcServer)
 	if err != nil {
-    http.Error(w, err.Error(), http.StatusInternalServerError)
-  	return
+		log.Fatalf("failed to start embedding service client pool: %v", err)
 	}
+	defer pool.Close()
 
-	defer conn.Close()
-
-  client := pb.NewEmbeddingServiceClient(conn)
+	mux := gateway.NewMux()
+	gateway.RegisterEmbeddingService(mux, pool.Get)
 
-  resp, err := client.GetRelatedEmbeddings(context.Background(), &pb.GetRelatedEmbeddingsRequest{
-		WebsiteIds: []string{"1", "2"},
-  })
-  if err != nil {
-    http.Error(w, err.Error(), http.StatusInternalServerError)
-    return
- 	}
+	handler := grpcOrREST(grpcServer, mux)
 
-	w.Header().Set("Content-Type", "application/json")
-  json.NewEncoder(w).Encode(resp)
-}
+	if *certFile != "" && *keyFile != "" {
+		srv := &http.Server{
+			Addr:      addr,
+			Handler:   handler,
+			TLSConfig: &tls.Config{NextProtos: []string{"h2", "http/1.1"}},
+		}
 
-func main() {
-	s := &server{}
-	http.HandleFunc("/embeddings", s.getEmbeddings)
+		log.Printf("gRPC + REST server listening on %s (TLS)", addr)
+		if err := srv.ListenAndServeTLS(*certFile, *keyFile); err != nil {
+			log.Fatalf("failed to serve: %v", err)
+		}
+		return
+	}
 
-	log.Printf("REST server listening on :8080")
-	if err := http.ListenAndServe(":8080", nil); err != nil {
+	log.Printf("gRPC + REST server listening on %s (h2c)", addr)
+	if err := http.ListenAndServe(addr, h2c.NewHandler(handler, &http2.Server{})); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGrpcOrRESTRoutesByProtoAndContentType(t *testing.T) {
+	grpcHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Handled-By", "grpc")
+	})
+	restMux := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Handled-By", "rest")
+	})
+	handler := grpcOrREST(grpcHandler, restMux)
+
+	tests := []struct {
+		name        string
+		protoMajor  int
+		contentType string
+		want        string
+	}{
+		{"http2 grpc content type goes to grpc", 2, "application/grpc", "grpc"},
+		{"http2 grpc+proto content type goes to grpc", 2, "application/grpc+proto", "grpc"},
+		{"http2 json content type falls through to rest", 2, "application/json", "rest"},
+		{"http1 grpc content type still falls through to rest", 1, "application/grpc", "rest"},
+		{"no content type falls through to rest", 2, "", "rest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", nil)
+			r.ProtoMajor = tt.protoMajor
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+
+			if got := rec.Header().Get("X-Handled-By"); got != tt.want {
+				t.Errorf("handled by %q, want %q", got, tt.want)
+			}
+		})
+	}
+}